@@ -22,8 +22,8 @@
 // A simple barrier implemented using mutex and unbuffered channel
 // Issues:
 // None I hope
-//1. Change mutex to atomic variable
-//2. Make it a reusable barrier
+//1. Change mutex to atomic variable -- done, see sync/barrier.Reusable
+//2. Make it a reusable barrier -- done, see sync/barrier.Reusable
 //--------------------------------------------
 
 package main
@@ -31,49 +31,18 @@ package main
 import (
 	"fmt"
 	"sync"
-	"sync/atomic"
 	"time"
-)
-
-type ReusableBarrier struct {
-	max        int32
-	arrived    int32
-	waitChan   chan bool
-	generation int32
-}
-
-func NewReusableBarrier(max int) *ReusableBarrier {
-	return &ReusableBarrier{
-		max:        int32(max),
-		arrived:    0,
-		waitChan:   make(chan bool),
-		generation: 0,
-	}
-}
 
-func (b *ReusableBarrier) Wait() {
-	newArrived := atomic.AddInt32(&b.arrived, 1)
-	atomic.LoadInt32(&b.generation)
-
-	if newArrived == b.max {
-		atomic.StoreInt32(&b.arrived, 0)
-		atomic.AddInt32(&b.generation, 1)
-
-		for i := int32(0); i < b.max-1; i++ {
-			b.waitChan <- true
-		}
-	} else {
-		<-b.waitChan
-	}
-}
+	"github.com/YimiaoHao/Go-projects-Yimiao-Hao/sync/barrier"
+)
 
-func doStuff(goNum int, barrier *ReusableBarrier, wg *sync.WaitGroup) {
+func doStuff(goNum int, br *barrier.Reusable, wg *sync.WaitGroup) {
 	defer wg.Done()
 
 	time.Sleep(time.Second)
 	fmt.Println("Part A", goNum)
 
-	barrier.Wait()
+	br.Wait()
 
 	fmt.Println("Part B", goNum)
 }
@@ -81,12 +50,12 @@ func doStuff(goNum int, barrier *ReusableBarrier, wg *sync.WaitGroup) {
 func main() {
 	totalRoutines := 10
 
-	barrier := NewReusableBarrier(totalRoutines)
+	br := barrier.NewReusable(totalRoutines)
 	var wg sync.WaitGroup
 	wg.Add(totalRoutines)
 
 	for i := 0; i < totalRoutines; i++ {
-		go doStuff(i, barrier, &wg)
+		go doStuff(i, br, &wg)
 	}
 
 	wg.Wait()
@@ -95,7 +64,7 @@ func main() {
 	fmt.Println("\nCommencing the second round of implementation...")
 	wg.Add(totalRoutines)
 	for i := 0; i < totalRoutines; i++ {
-		go doStuff(i+10, barrier, &wg)
+		go doStuff(i+10, br, &wg)
 	}
 	wg.Wait()
 	fmt.Println("All goroutines have completed their second round of execution.")
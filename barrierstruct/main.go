@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/YimiaoHao/Go-projects-Yimiao-Hao/sync/barrier"
+)
+
+func WorkWithRendezvous(wg *sync.WaitGroup, id int, b barrier.Barrier) {
+	defer wg.Done()
+
+	time.Sleep(time.Duration(rand.IntN(5)) * time.Second)
+
+	fmt.Println("Part A", id)
+
+	b.Wait()
+
+	fmt.Println("Part B", id)
+}
+
+func main() {
+	threadCount := 5
+	var wg sync.WaitGroup
+	wg.Add(threadCount)
+
+	b := barrier.NewCentral(threadCount)
+
+	for i := 0; i < threadCount; i++ {
+		go WorkWithRendezvous(&wg, i, b)
+	}
+
+	wg.Wait()
+}
@@ -20,6 +20,8 @@
 // Modified by:
 // Issues:
 // The barrier is not implemented!
+// Update: now backed by sync/barrier.Central instead of its own ad-hoc
+// mutex/channel barrier type.
 //--------------------------------------------
 
 /*
@@ -71,44 +73,17 @@ import (
 	"fmt"
 	"sync"
 	"time"
-)
-
-type barrier struct {
-	total int
-	count int
-	mu    sync.Mutex
-	sem   chan struct{}
-}
 
-func newBarrier(n int) *barrier {
-	return &barrier{
-		total: n,
-		sem:   make(chan struct{}),
-	}
-}
-
-func (b *barrier) wait() {
-	b.mu.Lock()
-	b.count++
-	last := (b.count == b.total)
-	b.mu.Unlock()
-
-	if last {
-		for i := 0; i < b.total-1; i++ {
-			b.sem <- struct{}{}
-		}
-	} else {
-		<-b.sem
-	}
-}
+	"github.com/YimiaoHao/Go-projects-Yimiao-Hao/sync/barrier"
+)
 
-func doStuff(id int, wg *sync.WaitGroup, br *barrier) {
+func doStuff(id int, wg *sync.WaitGroup, br barrier.Barrier) {
 	defer wg.Done()
 
 	time.Sleep(time.Second)
 	fmt.Println("Part A", id)
 
-	br.wait()
+	br.Wait()
 
 	fmt.Println("Part B", id)
 }
@@ -118,7 +93,7 @@ func main() {
 	var wg sync.WaitGroup
 	wg.Add(n)
 
-	br := newBarrier(n)
+	br := barrier.NewCentral(n)
 
 	for i := 0; i < n; i++ {
 		go doStuff(i, &wg, br)
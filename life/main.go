@@ -1,16 +1,21 @@
 package main
 
 import (
+	"flag"        // -pattern/-wrap command-line flags
+	"fmt"         // formatting the gen/s overlay text
 	"image/color" // color utilities
 	"log"         // logging
 	"math/rand"   // random number generation
-	"runtime"     // runtime control (e.g. GOMAXPROCS)
-	"sync"        // WaitGroup for goroutine sync
 	"time"        // time utilities
 
-	"github.com/hajimehoshi/ebiten/v2" // game / rendering framework
+	"github.com/hajimehoshi/ebiten/v2"      // game / rendering framework
+	"github.com/hajimehoshi/ebiten/v2/text" // overlay text rendering
+	"golang.org/x/image/font/basicfont"     // default fixed-width font face
 )
 
+// metricsFace is the font the gen/s overlay is drawn with.
+var metricsFace = basicfont.Face7x13
+
 const (
 	w         = 300 // grid width (logical)
 	h         = 300 // grid height (logical)
@@ -23,11 +28,22 @@ type ArticleStyleGame struct {
 	grid     [w][h]uint8 // current generation: 0 = dead, 1 = alive
 	buffer   [w][h]uint8 // next generation buffer, to avoid overwriting
 	frameCnt int         // frame counter, used to throttle updates
+
+	Wrap bool   // treat the grid as a torus instead of clamping dead at the border
+	Rule string // Life-like "B.../S..." rule; empty means Conway's B3/S23
+	rl   rule   // parsed form of Rule, refreshed by setRule
+
+	pool    *workerPool // persistent tile workers used by parallelUpdate
+	metrics *metrics    // smoothed generations-per-second tracker
 }
 
 // NewArticleStyleGame creates and seeds a new game
 func NewArticleStyleGame() *ArticleStyleGame {
 	g := &ArticleStyleGame{}
+	if err := g.setRule(conwayRule); err != nil {
+		// conwayRule is a constant we control, so this can't happen.
+		panic(err)
+	}
 	// seed the grid with random cells (skip the outer border)
 	for x := 1; x < w-1; x++ {
 		for y := 1; y < h-1; y++ {
@@ -36,54 +52,67 @@ func NewArticleStyleGame() *ArticleStyleGame {
 			}
 		}
 	}
+	g.pool = newWorkerPool(g)
+	g.metrics = newMetrics()
 	return g
 }
 
-// parallelUpdate computes the next generation in parallel
-func (g *ArticleStyleGame) parallelUpdate() {
-	var wg sync.WaitGroup
-	// let Go use all available CPU cores
-	runtime.GOMAXPROCS(runtime.NumCPU())
+// setRule parses s and, on success, stores it as both the raw Rule
+// string and its parsed form.
+func (g *ArticleStyleGame) setRule(s string) error {
+	rl, err := parseRule(s)
+	if err != nil {
+		return err
+	}
+	g.Rule = s
+	g.rl = rl
+	return nil
+}
 
-	// process each column in a separate goroutine
-	for x := 1; x < w-1; x++ {
-		wg.Add(1)
-		// capture x
-		go func(x int) {
-			defer wg.Done()
-
-			// iterate over all rows in this column (skip top/bottom border)
-			for y := 1; y < h-1; y++ {
-				// count 8 neighbours around (x,y)
-				n := g.grid[x-1][y-1] + // top-left
-					g.grid[x-1][y] + // left
-					g.grid[x-1][y+1] + // bottom-left
-					g.grid[x][y-1] + // top
-					g.grid[x][y+1] + // bottom
-					g.grid[x+1][y-1] + // top-right
-					g.grid[x+1][y] + // right
-					g.grid[x+1][y+1] // bottom-right
-
-				// apply Conway's rules into the buffer
-				if g.grid[x][y] == 0 && n == 3 {
-					// dead cell with exactly 3 neighbours → birth
-					g.buffer[x][y] = 1
-				} else if n < 2 || n > 3 {
-					// underpopulation or overpopulation → death
-					g.buffer[x][y] = 0
-				} else {
-					// stays the same
-					g.buffer[x][y] = g.grid[x][y]
-				}
+// neighborCount returns the number of live neighbours around (x,y). When
+// Wrap is set the grid is treated as a torus, so every cell has 8
+// neighbours; otherwise neighbours that fall outside the board are
+// simply absent, which is what leaves the outer border permanently dead.
+func (g *ArticleStyleGame) neighborCount(x, y int) uint8 {
+	var n uint8
+	for dx := -1; dx <= 1; dx++ {
+		for dy := -1; dy <= 1; dy++ {
+			if dx == 0 && dy == 0 {
+				continue
+			}
+			nx, ny := x+dx, y+dy
+			if g.Wrap {
+				nx = (nx + w) % w
+				ny = (ny + h) % h
+			} else if nx < 0 || nx >= w || ny < 0 || ny >= h {
+				continue
 			}
-		}(x)
+			n += g.grid[nx][ny]
+		}
 	}
-	// wait for all columns to finish
-	wg.Wait()
+	return n
+}
+
+// parallelUpdate computes the next generation in parallel, dispatching
+// the grid's tiles to g.pool's persistent workers instead of spawning a
+// goroutine per column every tick.
+func (g *ArticleStyleGame) parallelUpdate() {
+	for _, t := range tilesFor(g.Wrap) {
+		g.pool.jobs <- job{t: t}
+	}
+	// one sentinel per worker tells it this generation has no more
+	// tiles coming; see workerPool.worker for why that's enough to know
+	// the whole generation is done.
+	for i := 0; i < g.pool.workers; i++ {
+		g.pool.jobs <- job{sentinel: true}
+	}
+	g.pool.barrier.Wait()
 
 	// swap current grid and buffer
 	// (new generation becomes current, old current becomes next buffer)
 	g.grid, g.buffer = g.buffer, g.grid
+
+	g.metrics.tick()
 }
 
 // Update is called every frame by Ebiten
@@ -115,6 +144,11 @@ func (g *ArticleStyleGame) Draw(screen *ebiten.Image) {
 			}
 		}
 	}
+
+	// smoothed generations-per-second readout, top-left
+	min, max := g.metrics.minMax()
+	overlay := fmt.Sprintf("%.1f gen/s (min %.1f, max %.1f over 5s)", g.metrics.rate(), min, max)
+	text.Draw(screen, overlay, metricsFace, 4, 14, color.White)
 }
 
 // Layout defines the logical screen size
@@ -123,10 +157,21 @@ func (g *ArticleStyleGame) Layout(ow, oh int) (int, int) {
 }
 
 func main() {
+	pattern := flag.String("pattern", "", "path to a pattern file (.rle or .lif) to seed the grid with instead of a random fill")
+	wrap := flag.Bool("wrap", false, "treat the grid as a torus, wrapping neighbours across the border")
+	flag.Parse()
+
 	// seed RNG once
 	rand.Seed(time.Now().UnixNano())
 
 	game := NewArticleStyleGame()
+	game.Wrap = *wrap
+
+	if *pattern != "" {
+		if err := game.loadPatternFile(*pattern); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	ebiten.SetWindowSize(w, h)
 	ebiten.SetWindowTitle("Game of Life (parallel from article)")
@@ -0,0 +1,102 @@
+package main
+
+import "time"
+
+const (
+	defaultAlpha  = 0.2             // EWMA smoothing factor used once warmed up
+	warmupSamples = 5               // samples averaged arithmetically before switching to EWMA
+	historyWindow = 5 * time.Second // window over which min/max are tracked
+)
+
+// metrics tracks per-generation wall-clock cost and exposes a smoothed
+// generations-per-second estimate, the same way progress-bar libraries
+// use an EWMA over per-tick elapsed time to give a stable ETA instead of
+// one that jumps around with every sample.
+type metrics struct {
+	alpha    float64
+	warmup   int
+	lastTick time.Time
+
+	samples int     // samples seen so far during warm-up, capped at warmup
+	sum     float64 // running sum of instantaneous rates during warm-up
+	ewma    float64 // smoothed generations-per-second once warmed up
+
+	history []rateSample // instantaneous rates within the last historyWindow
+}
+
+type rateSample struct {
+	at   time.Time
+	rate float64
+}
+
+// newMetrics returns a metrics tracker with the default smoothing factor
+// and warm-up length.
+func newMetrics() *metrics {
+	return &metrics{alpha: defaultAlpha, warmup: warmupSamples}
+}
+
+// tick records that a generation has just completed and refreshes the
+// smoothed rate. Call it once per parallelUpdate.
+func (m *metrics) tick() {
+	m.tickAt(time.Now())
+}
+
+// tickAt is tick with the current time passed in explicitly, so the
+// EWMA/warm-up/min-max arithmetic can be exercised with known timestamps
+// in a test.
+func (m *metrics) tickAt(now time.Time) {
+	if m.lastTick.IsZero() {
+		m.lastTick = now
+		return
+	}
+	dt := now.Sub(m.lastTick)
+	m.lastTick = now
+	if dt <= 0 {
+		return
+	}
+	rate := 1 / dt.Seconds()
+
+	if m.samples < m.warmup {
+		m.samples++
+		m.sum += rate
+		m.ewma = m.sum / float64(m.samples)
+	} else {
+		m.ewma = m.alpha*rate + (1-m.alpha)*m.ewma
+	}
+
+	m.history = append(m.history, rateSample{now, rate})
+	m.prune(now)
+}
+
+// prune drops samples older than historyWindow.
+func (m *metrics) prune(now time.Time) {
+	cutoff := now.Add(-historyWindow)
+	i := 0
+	for i < len(m.history) && m.history[i].at.Before(cutoff) {
+		i++
+	}
+	m.history = m.history[i:]
+}
+
+// rate returns the current smoothed generations-per-second estimate.
+func (m *metrics) rate() float64 {
+	return m.ewma
+}
+
+// minMax returns the smallest and largest instantaneous rate observed
+// within the last historyWindow.
+func (m *metrics) minMax() (min, max float64) {
+	if len(m.history) == 0 {
+		return 0, 0
+	}
+	min, max = m.history[0].rate, m.history[0].rate
+	for _, s := range m.history[1:] {
+		if s.rate < min {
+			min = s.rate
+		}
+		if s.rate > max {
+			max = s.rate
+		}
+	}
+	return min, max
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMetricsTickAt(t *testing.T) {
+	m := &metrics{alpha: 0.5, warmup: 2}
+	base := time.Unix(0, 0)
+
+	// The very first tick only seeds lastTick; there is no previous
+	// sample to compute a dt against yet.
+	m.tickAt(base)
+	if got := m.rate(); got != 0 {
+		t.Fatalf("rate after first tick = %v, want 0", got)
+	}
+
+	// Warm-up: samples are averaged arithmetically until warmup is hit.
+	m.tickAt(base.Add(1 * time.Second)) // dt=1s, rate=1 -> ewma = 1
+	if got := m.rate(); got != 1 {
+		t.Errorf("rate after 1st warm-up sample = %v, want 1", got)
+	}
+	m.tickAt(base.Add(1500 * time.Millisecond)) // dt=0.5s, rate=2 -> ewma = (1+2)/2 = 1.5
+	if got := m.rate(); got != 1.5 {
+		t.Errorf("rate after 2nd warm-up sample = %v, want 1.5", got)
+	}
+
+	// warmup (2) samples have now been seen, so this one goes through
+	// the EWMA branch instead: ewma = 0.5*1 + 0.5*1.5 = 1.25
+	m.tickAt(base.Add(2500 * time.Millisecond)) // dt=1s, rate=1
+	if got := m.rate(); got != 1.25 {
+		t.Errorf("rate after 1st EWMA sample = %v, want 1.25", got)
+	}
+
+	if min, max := m.minMax(); min != 1 || max != 2 {
+		t.Errorf("minMax = (%v, %v), want (1, 2)", min, max)
+	}
+}
+
+func TestMetricsTickAtNonPositiveDt(t *testing.T) {
+	m := &metrics{alpha: 0.5, warmup: 2}
+	base := time.Unix(0, 0)
+
+	m.tickAt(base)
+	m.tickAt(base.Add(time.Second)) // rate=1
+	m.tickAt(base)                  // dt <= 0: must be ignored
+
+	if got := m.rate(); got != 1 {
+		t.Errorf("rate after non-positive dt = %v, want unchanged 1", got)
+	}
+	if len(m.history) != 1 {
+		t.Errorf("history len = %d, want 1 (non-positive dt shouldn't add a sample)", len(m.history))
+	}
+}
+
+func TestMetricsPruneOldSamples(t *testing.T) {
+	m := &metrics{alpha: 0.2, warmup: 1}
+	base := time.Unix(0, 0)
+
+	m.tickAt(base)
+	m.tickAt(base.Add(1 * time.Second))               // rate=1, recorded at t=1s
+	m.tickAt(base.Add(historyWindow + 2*time.Second)) // far enough later that the t=1s sample ages out
+
+	if len(m.history) != 1 {
+		t.Fatalf("history len = %d, want 1 after pruning", len(m.history))
+	}
+	if min, max := m.minMax(); min != max {
+		t.Errorf("minMax = (%v, %v), want a single remaining sample (min == max)", min, max)
+	}
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/YimiaoHao/Go-projects-Yimiao-Hao/sync/barrier"
+)
+
+// benchGrid is a minimal, dynamically sized stand-in for
+// ArticleStyleGame's grid/buffer, used only so the two update strategies
+// below can be compared at sizes the fixed 300x300 board doesn't cover.
+type benchGrid struct {
+	n            int
+	grid, buffer [][]uint8
+}
+
+func newBenchGrid(n int) *benchGrid {
+	g := &benchGrid{n: n, grid: make([][]uint8, n), buffer: make([][]uint8, n)}
+	for x := range g.grid {
+		g.grid[x] = make([]uint8, n)
+		g.buffer[x] = make([]uint8, n)
+	}
+	for x := 1; x < n-1; x++ {
+		for y := 1; y < n-1; y++ {
+			g.grid[x][y] = uint8((x + y) % 2)
+		}
+	}
+	return g
+}
+
+func (g *benchGrid) apply(x, y int) {
+	n := g.grid[x-1][y-1] + g.grid[x-1][y] + g.grid[x-1][y+1] +
+		g.grid[x][y-1] + g.grid[x][y+1] +
+		g.grid[x+1][y-1] + g.grid[x+1][y] + g.grid[x+1][y+1]
+
+	if g.grid[x][y] == 0 && n == 3 {
+		g.buffer[x][y] = 1
+	} else if n < 2 || n > 3 {
+		g.buffer[x][y] = 0
+	} else {
+		g.buffer[x][y] = g.grid[x][y]
+	}
+}
+
+// updateColumnPerGoroutine mirrors the original parallelUpdate: one
+// goroutine per column, created and torn down every call.
+func (g *benchGrid) updateColumnPerGoroutine() {
+	var wg sync.WaitGroup
+	for x := 1; x < g.n-1; x++ {
+		wg.Add(1)
+		go func(x int) {
+			defer wg.Done()
+			for y := 1; y < g.n-1; y++ {
+				g.apply(x, y)
+			}
+		}(x)
+	}
+	wg.Wait()
+	g.grid, g.buffer = g.buffer, g.grid
+}
+
+// benchPool mirrors workerPool: a fixed set of workers reused across
+// generations, synchronized by a Reusable barrier instead of a fresh
+// sync.WaitGroup every tick.
+type benchPool struct {
+	g       *benchGrid
+	tiles   []tile
+	jobs    chan job
+	workers int
+	bar     *barrier.Reusable
+}
+
+func newBenchPool(g *benchGrid) *benchPool {
+	workers := runtime.GOMAXPROCS(0)
+	p := &benchPool{
+		g:       g,
+		tiles:   benchTiles(g.n),
+		jobs:    make(chan job),
+		workers: workers,
+		bar:     barrier.NewReusable(workers + 1),
+	}
+	for i := 0; i < workers; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+func benchTiles(n int) []tile {
+	var tiles []tile
+	for x0 := 1; x0 < n-1; x0 += tileSize {
+		x1 := min(x0+tileSize, n-1)
+		for y0 := 1; y0 < n-1; y0 += tileSize {
+			y1 := min(y0+tileSize, n-1)
+			tiles = append(tiles, tile{x0, y0, x1, y1})
+		}
+	}
+	return tiles
+}
+
+func (p *benchPool) worker() {
+	for j := range p.jobs {
+		if j.sentinel {
+			p.bar.Wait()
+			continue
+		}
+		for x := j.t.x0; x < j.t.x1; x++ {
+			for y := j.t.y0; y < j.t.y1; y++ {
+				p.g.apply(x, y)
+			}
+		}
+	}
+}
+
+func (p *benchPool) update() {
+	for _, t := range p.tiles {
+		p.jobs <- job{t: t}
+	}
+	for i := 0; i < p.workers; i++ {
+		p.jobs <- job{sentinel: true}
+	}
+	p.bar.Wait()
+	p.g.grid, p.g.buffer = p.g.buffer, p.g.grid
+}
+
+var benchSizes = []int{300, 1024, 4096}
+
+func BenchmarkColumnPerGoroutine(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("%dx%d", n, n), func(b *testing.B) {
+			g := newBenchGrid(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				g.updateColumnPerGoroutine()
+			}
+		})
+	}
+}
+
+func BenchmarkTilePool(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("%dx%d", n, n), func(b *testing.B) {
+			g := newBenchGrid(n)
+			p := newBenchPool(g)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				p.update()
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"runtime"
+
+	"github.com/YimiaoHao/Go-projects-Yimiao-Hao/sync/barrier"
+)
+
+// tileSize is the edge length of the square tiles parallelUpdate
+// partitions the grid into. 32x32 keeps a tile's working set small
+// enough to stay cache-friendly while still being far cheaper to
+// dispatch than one job per cell.
+const tileSize = 32
+
+// tile is a half-open [x0,x1) x [y0,y1) rectangle of the grid.
+type tile struct{ x0, y0, x1, y1 int }
+
+// job is what travels down workerPool.jobs: either a tile to update, or
+// (sentinel == true) a "this generation's tiles are all dispatched"
+// marker. Dispatching exactly one sentinel per worker per generation,
+// always after every real tile, is what lets a worker know it has
+// finished its share of the current generation without a fresh
+// sync.WaitGroup every tick.
+type job struct {
+	t        tile
+	sentinel bool
+}
+
+// workerPool runs parallelUpdate's grid tiles across a fixed set of
+// long-lived workers. Workers are created once, in newWorkerPool, and
+// reused generation after generation; only jobs and a generation of the
+// barrier cross tick boundaries, so there is no per-tick goroutine
+// creation the way the original one-goroutine-per-column design had.
+type workerPool struct {
+	jobs    chan job
+	workers int
+	barrier *barrier.Reusable // workers + the dispatching goroutine rendezvous here
+}
+
+// newWorkerPool starts a pool sized to the current GOMAXPROCS, one
+// worker per logical CPU, operating on g.
+func newWorkerPool(g *ArticleStyleGame) *workerPool {
+	workers := runtime.GOMAXPROCS(0)
+	p := &workerPool{
+		jobs:    make(chan job),
+		workers: workers,
+		barrier: barrier.NewReusable(workers + 1),
+	}
+	for i := 0; i < workers; i++ {
+		go p.worker(g)
+	}
+	return p
+}
+
+func (p *workerPool) worker(g *ArticleStyleGame) {
+	for j := range p.jobs {
+		if j.sentinel {
+			// Every tile sent before this worker's sentinel has, by
+			// construction, already been fully processed by whichever
+			// worker dequeued it (jobs is a single FIFO channel, and a
+			// worker never receives its next job until it is done with
+			// its current one). Waiting here for every worker to reach
+			// its own sentinel is therefore enough to know the whole
+			// generation is done.
+			p.barrier.Wait()
+			continue
+		}
+		g.updateTile(j.t)
+	}
+}
+
+// tilesFor partitions the region parallelUpdate operates over (the full
+// torus when wrap is set, the interior otherwise) into tileSize x
+// tileSize tiles, clipped at the edges.
+func tilesFor(wrap bool) []tile {
+	xStart, xEnd := 1, w-1
+	yStart, yEnd := 1, h-1
+	if wrap {
+		xStart, xEnd = 0, w
+		yStart, yEnd = 0, h
+	}
+
+	var tiles []tile
+	for x0 := xStart; x0 < xEnd; x0 += tileSize {
+		x1 := min(x0+tileSize, xEnd)
+		for y0 := yStart; y0 < yEnd; y0 += tileSize {
+			y1 := min(y0+tileSize, yEnd)
+			tiles = append(tiles, tile{x0, y0, x1, y1})
+		}
+	}
+	return tiles
+}
+
+// updateTile recomputes g.buffer for every cell in t from g.grid.
+func (g *ArticleStyleGame) updateTile(t tile) {
+	for x := t.x0; x < t.x1; x++ {
+		for y := t.y0; y < t.y1; y++ {
+			n := g.neighborCount(x, y)
+			if g.grid[x][y] == 0 && g.rl.birth[n] {
+				g.buffer[x][y] = 1
+			} else if g.grid[x][y] == 1 && g.rl.survive[n] {
+				g.buffer[x][y] = 1
+			} else {
+				g.buffer[x][y] = 0
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// aliveCount returns the number of live cells on g's grid.
+func aliveCount(g *ArticleStyleGame) int {
+	n := 0
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			n += int(g.grid[x][y])
+		}
+	}
+	return n
+}
+
+func TestLoadPatternFile(t *testing.T) {
+	tests := []struct {
+		path  string
+		alive int
+	}{
+		{"patterns/blinker.rle", 3},
+		{"patterns/toad.rle", 6},
+		{"patterns/gosper_glider_gun.rle", 36},
+		{"patterns/glider.lif", 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			g := NewArticleStyleGame()
+			if err := g.loadPatternFile(tt.path); err != nil {
+				t.Fatalf("loadPatternFile(%q): %v", tt.path, err)
+			}
+			if got := aliveCount(g); got != tt.alive {
+				t.Errorf("alive cells = %d, want %d", got, tt.alive)
+			}
+		})
+	}
+}
+
+func TestLoadRLESetsRule(t *testing.T) {
+	g := NewArticleStyleGame()
+	if err := g.loadPatternFile("patterns/blinker.rle"); err != nil {
+		t.Fatalf("loadPatternFile: %v", err)
+	}
+	if g.Rule != "B3/S23" {
+		t.Errorf("Rule = %q, want %q", g.Rule, "B3/S23")
+	}
+}
+
+func TestLoadRLEErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"missing header", "# just a comment\n"},
+		{"missing x/y", "rule = B3/S23\n3o!\n"},
+		{"bad tag", "x = 3, y = 1\n3z!\n"},
+		{"missing terminator", "x = 3, y = 1\n3o\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewArticleStyleGame()
+			if err := g.LoadRLE(strings.NewReader(tt.in)); err == nil {
+				t.Errorf("LoadRLE(%q): expected an error, got nil", tt.in)
+			}
+		})
+	}
+}
+
+func TestLoadLife106Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"missing header", "0 1\n1 2\n"},
+		{"malformed coordinate line", "#Life 1.06\n0 1 2\n"},
+		{"non-numeric coordinate", "#Life 1.06\nfoo bar\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewArticleStyleGame()
+			if err := g.LoadLife106(strings.NewReader(tt.in)); err == nil {
+				t.Errorf("LoadLife106(%q): expected an error, got nil", tt.in)
+			}
+		})
+	}
+}
+
+// TestPlaceCellsDropsOutOfBounds exercises placeCells directly: with a
+// pattern much larger than the board, centering pushes part of it
+// outside the grid, and those cells should be dropped rather than
+// wrapping or panicking.
+func TestPlaceCellsDropsOutOfBounds(t *testing.T) {
+	g := NewArticleStyleGame()
+	// offX = offY = (300-1000)/2 = -350, so pattern-local (0,0) maps to
+	// (-350,-350) (out of bounds, dropped) while (350,350) maps to
+	// (0,0) (in bounds, kept).
+	g.placeCells([]point{{0, 0}, {350, 350}}, 1000, 1000)
+
+	if got := aliveCount(g); got != 1 {
+		t.Fatalf("alive cells = %d, want 1 (the out-of-bounds cell should be dropped)", got)
+	}
+	if g.grid[0][0] != 1 {
+		t.Errorf("expected the in-bounds cell to land at (0,0)")
+	}
+}
+
+func TestNeighborCountWrap(t *testing.T) {
+	g := NewArticleStyleGame()
+	g.grid = [w][h]uint8{}
+	g.grid[w-1][0] = 1
+	g.grid[0][h-1] = 1
+
+	g.Wrap = true
+	if got := g.neighborCount(0, 0); got != 2 {
+		t.Errorf("neighborCount(0,0) with wrap = %d, want 2", got)
+	}
+
+	g.Wrap = false
+	if got := g.neighborCount(0, 0); got != 0 {
+		t.Errorf("neighborCount(0,0) without wrap = %d, want 0", got)
+	}
+}
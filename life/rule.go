@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// conwayRule is the rule ArticleStyleGame starts with when nothing else
+// is requested: Conway's standard "birth on 3, survive on 2 or 3".
+const conwayRule = "B3/S23"
+
+// rule holds the parsed birth/survive neighbour-count sets for a
+// Life-like "B.../S..." rule string, e.g. "B3/S23" or "B36/S23" (HighLife).
+type rule struct {
+	birth   [9]bool
+	survive [9]bool
+}
+
+// parseRule parses a rule string of the form "B<digits>/S<digits>",
+// where each digit (0-8) is a neighbour count that triggers a birth or a
+// survival respectively. The two segments may appear in either order.
+func parseRule(s string) (rule, error) {
+	var rl rule
+	segments := strings.Split(s, "/")
+	if len(segments) != 2 {
+		return rule{}, fmt.Errorf("life: invalid rule %q: expected B.../S...", s)
+	}
+	for _, seg := range segments {
+		seg = strings.TrimSpace(seg)
+		if seg == "" {
+			return rule{}, fmt.Errorf("life: invalid rule %q: empty segment", s)
+		}
+		var counts *[9]bool
+		switch seg[0] {
+		case 'B', 'b':
+			counts = &rl.birth
+		case 'S', 's':
+			counts = &rl.survive
+		default:
+			return rule{}, fmt.Errorf("life: invalid rule %q: segment %q must start with B or S", s, seg)
+		}
+		for _, d := range seg[1:] {
+			n, err := strconv.Atoi(string(d))
+			if err != nil || n < 0 || n > 8 {
+				return rule{}, fmt.Errorf("life: invalid rule %q: bad neighbour count %q", s, string(d))
+			}
+			counts[n] = true
+		}
+	}
+	return rl, nil
+}
@@ -0,0 +1,67 @@
+package barrier
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+var sizes = []int{2, 4, 8, 16, 32, 64, 128, 256, 512, 1024}
+
+// benchmarkBarrier drives n goroutines through b.Wait() for b.N barrier
+// crossings each, timing how long the whole run takes.
+func benchmarkBarrier(b *testing.B, n int, newWaiter func(id int) Barrier) {
+	var wg sync.WaitGroup
+	wg.Add(n)
+	b.ResetTimer()
+	for id := 0; id < n; id++ {
+		go func(id int) {
+			defer wg.Done()
+			w := newWaiter(id)
+			for i := 0; i < b.N; i++ {
+				w.Wait()
+			}
+		}(id)
+	}
+	wg.Wait()
+}
+
+func BenchmarkCentral(b *testing.B) {
+	for _, n := range sizes {
+		b.Run(sizeName(n), func(b *testing.B) {
+			bar := NewCentral(n)
+			benchmarkBarrier(b, n, func(int) Barrier { return bar })
+		})
+	}
+}
+
+func BenchmarkReusable(b *testing.B) {
+	for _, n := range sizes {
+		b.Run(sizeName(n), func(b *testing.B) {
+			bar := NewReusable(n)
+			benchmarkBarrier(b, n, func(int) Barrier { return bar })
+		})
+	}
+}
+
+func BenchmarkTournament(b *testing.B) {
+	for _, n := range sizes {
+		b.Run(sizeName(n), func(b *testing.B) {
+			bar := NewTournament(n)
+			benchmarkBarrier(b, n, func(id int) Barrier { return bar.Participant(id) })
+		})
+	}
+}
+
+func BenchmarkDissemination(b *testing.B) {
+	for _, n := range sizes {
+		b.Run(sizeName(n), func(b *testing.B) {
+			bar := NewDissemination(n)
+			benchmarkBarrier(b, n, func(id int) Barrier { return bar.Participant(id) })
+		})
+	}
+}
+
+func sizeName(n int) string {
+	return fmt.Sprintf("N=%d", n)
+}
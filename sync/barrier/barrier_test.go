@@ -0,0 +1,122 @@
+package barrier
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// correctnessSizes covers n=1 (the degenerate case) alongside several
+// non-power-of-two sizes, which is what actually exercises the "bye"
+// handling in Tournament/Dissemination's round topology.
+var correctnessSizes = []int{1, 2, 3, 5, 8, 17}
+
+// runSynchronizationCheck drives n participants through rounds
+// generations of newWaiter(id), failing t if any participant's Wait
+// returns before all n participants have arrived for that round.
+// arrivedPerRound[r] is only ever written during round r, so reading it
+// immediately after a participant's r-th Wait returns is race-free no
+// matter what faster participants are doing in later rounds: if the
+// barrier is correct, every participant's increment for round r has
+// already landed by the time any of them is released from round r.
+func runSynchronizationCheck(t *testing.T, n, rounds int, newWaiter func(id int) Barrier) {
+	t.Helper()
+	arrivedPerRound := make([]int32, rounds)
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for id := 0; id < n; id++ {
+		go func(id int) {
+			defer wg.Done()
+			w := newWaiter(id)
+			for r := 0; r < rounds; r++ {
+				atomic.AddInt32(&arrivedPerRound[r], 1)
+				w.Wait()
+				if got := atomic.LoadInt32(&arrivedPerRound[r]); got != int32(n) {
+					t.Errorf("participant %d released for round %d before all %d arrived (saw %d)", id, r, n, got)
+				}
+			}
+		}(id)
+	}
+	wg.Wait()
+}
+
+func TestCentralSynchronizes(t *testing.T) {
+	for _, n := range correctnessSizes {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			bar := NewCentral(n)
+			runSynchronizationCheck(t, n, 50, func(int) Barrier { return bar })
+		})
+	}
+}
+
+func TestReusableSynchronizes(t *testing.T) {
+	for _, n := range correctnessSizes {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			bar := NewReusable(n)
+			// Thousands of trips through the same generation-swap path,
+			// so -race has a real chance of catching a bad swap.
+			runSynchronizationCheck(t, n, 3000, func(int) Barrier { return bar })
+		})
+	}
+}
+
+func TestTournamentSynchronizes(t *testing.T) {
+	for _, n := range correctnessSizes {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			bar := NewTournament(n)
+			runSynchronizationCheck(t, n, 50, func(id int) Barrier { return bar.Participant(id) })
+		})
+	}
+}
+
+func TestDisseminationSynchronizes(t *testing.T) {
+	for _, n := range correctnessSizes {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			bar := NewDissemination(n)
+			runSynchronizationCheck(t, n, 50, func(id int) Barrier { return bar.Participant(id) })
+		})
+	}
+}
+
+func TestInstrumentedSynchronizes(t *testing.T) {
+	for _, n := range correctnessSizes {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			base := NewCentral(n)
+			ig := NewInstrumented(n, func(int) Barrier { return base })
+			runSynchronizationCheck(t, n, 50, func(id int) Barrier { return ig.Participant(id) })
+		})
+	}
+}
+
+// TestInstrumentedPublishDoesNotBlock checks that InstrumentedGroup's
+// publish keeps participants moving even when nothing ever reads
+// Snapshots, and that the snapshot it does keep around is well formed.
+func TestInstrumentedPublishDoesNotBlock(t *testing.T) {
+	const n = 4
+	base := NewCentral(n)
+	ig := NewInstrumented(n, func(int) Barrier { return base })
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for id := 0; id < n; id++ {
+		go func(id int) {
+			defer wg.Done()
+			p := ig.Participant(id)
+			for r := 0; r < 20; r++ {
+				p.Wait()
+			}
+		}(id)
+	}
+	wg.Wait() // must not hang even though ig.Snapshots is never drained
+
+	select {
+	case snap := <-ig.Snapshots:
+		if len(snap.Arrivals) != n {
+			t.Errorf("Arrivals len = %d, want %d", len(snap.Arrivals), n)
+		}
+	default:
+		t.Fatal("expected a buffered snapshot from the last generation")
+	}
+}
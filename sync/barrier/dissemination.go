@@ -0,0 +1,66 @@
+package barrier
+
+import (
+	"context"
+	"math/bits"
+)
+
+// Dissemination is a barrier that synchronizes n participants over
+// ceil(log2(n)) rounds: in round k, participant i signals participant
+// (i+2^k) mod n and waits on a signal from participant (i-2^k) mod n.
+// Unlike Tournament, every participant is active in every round (there
+// are no winners/losers waiting idle for a wake-down phase), which trades
+// a slightly higher message count for a shallower, more uniform critical
+// path. As with Tournament, participants are registered with a stable id
+// at construction so the per-round partners can be precomputed once.
+type Dissemination struct {
+	n      int
+	rounds int
+	signal [][]chan struct{} // signal[round][id]: channel id receives its round-k signal on
+}
+
+// NewDissemination builds the round topology for n participants. Use
+// Participant to obtain the Barrier handle for a given id.
+func NewDissemination(n int) *Dissemination {
+	rounds := 0
+	if n > 1 {
+		rounds = bits.Len(uint(n - 1))
+	}
+
+	d := &Dissemination{n: n, rounds: rounds}
+	d.signal = make([][]chan struct{}, rounds)
+	for r := range d.signal {
+		d.signal[r] = make([]chan struct{}, n)
+		for id := range d.signal[r] {
+			// Buffered by one: the sender for this (round, id) pair is
+			// fixed, so a single slot is always enough.
+			d.signal[r][id] = make(chan struct{}, 1)
+		}
+	}
+	return d
+}
+
+// Participant returns the Barrier handle for the participant registered
+// with the given stable id (0..n-1).
+func (d *Dissemination) Participant(id int) Barrier {
+	return &disseminationParticipant{d: d, id: id}
+}
+
+type disseminationParticipant struct {
+	d  *Dissemination
+	id int
+}
+
+func (p *disseminationParticipant) Wait() {
+	d, id := p.d, p.id
+	for r := 0; r < d.rounds; r++ {
+		step := 1 << r
+		target := (id + step) % d.n
+		d.signal[r][target] <- struct{}{}
+		<-d.signal[r][id]
+	}
+}
+
+func (p *disseminationParticipant) WaitCtx(ctx context.Context) error {
+	return waitCtx(ctx, p.Wait)
+}
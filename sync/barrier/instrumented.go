@@ -0,0 +1,112 @@
+package barrier
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Snapshot is one generation's arrival timeline, with Arrivals[id] being
+// how long after the first arrival participant id called Wait.
+type Snapshot struct {
+	Generation int
+	Arrivals   []time.Duration
+}
+
+// InstrumentedGroup is the shared state behind a set of Instrumented
+// participant handles. It records each participant's arrival time for
+// the in-progress generation and publishes a Snapshot, over Snapshots,
+// once every participant has arrived. Wrapping a Barrier in an
+// InstrumentedGroup is opt-in, so a Barrier that is never wrapped (the
+// common case) pays none of this bookkeeping.
+type InstrumentedGroup struct {
+	n    int
+	base func(id int) Barrier // underlying barrier(s) actually synchronizing participants
+
+	mu      sync.Mutex
+	gen     int
+	start   time.Time
+	arrived []time.Time
+	count   int
+
+	// Snapshots receives the latest completed generation's arrival
+	// timeline. It is buffered to 1 and kept drained to the newest
+	// snapshot, so a slow or absent reader (e.g. no visualizer attached)
+	// never blocks a participant's Wait.
+	Snapshots chan Snapshot
+}
+
+// NewInstrumented wraps n participants' arrivals for instrumentation.
+// base(id) must return the Barrier that id should actually wait on: for
+// Central and Reusable, every id shares the same Barrier; for Tournament
+// and Dissemination, base is typically their own Participant method.
+func NewInstrumented(n int, base func(id int) Barrier) *InstrumentedGroup {
+	return &InstrumentedGroup{
+		n:         n,
+		base:      base,
+		arrived:   make([]time.Time, n),
+		Snapshots: make(chan Snapshot, 1),
+	}
+}
+
+// Participant returns the instrumented Barrier handle for participant id.
+func (ig *InstrumentedGroup) Participant(id int) Barrier {
+	return &instrumentedParticipant{ig: ig, id: id, inner: ig.base(id)}
+}
+
+type instrumentedParticipant struct {
+	ig    *InstrumentedGroup
+	id    int
+	inner Barrier
+}
+
+func (p *instrumentedParticipant) Wait() {
+	ig := p.ig
+
+	ig.mu.Lock()
+	if ig.count == 0 {
+		ig.start = time.Now()
+	}
+	ig.arrived[p.id] = time.Now()
+	ig.count++
+
+	var snap Snapshot
+	publish := ig.count == ig.n
+	if publish {
+		snap = Snapshot{Generation: ig.gen, Arrivals: make([]time.Duration, ig.n)}
+		for i, t := range ig.arrived {
+			snap.Arrivals[i] = t.Sub(ig.start)
+		}
+		ig.gen++
+		ig.count = 0
+	}
+	ig.mu.Unlock()
+
+	if publish {
+		ig.publish(snap)
+	}
+
+	p.inner.Wait()
+}
+
+// publish delivers snap, discarding a stale unread snapshot rather than
+// blocking the participant that just completed the last arrival.
+func (ig *InstrumentedGroup) publish(snap Snapshot) {
+	select {
+	case ig.Snapshots <- snap:
+		return
+	default:
+	}
+	select {
+	case <-ig.Snapshots:
+	default:
+	}
+	select {
+	case ig.Snapshots <- snap:
+	default:
+	}
+}
+
+func (p *instrumentedParticipant) WaitCtx(ctx context.Context) error {
+	return waitCtx(ctx, p.Wait)
+}
@@ -0,0 +1,48 @@
+package barrier
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Reusable is a barrier that can be waited on repeatedly (one "generation"
+// per trip) without needing to be recreated. Unlike barrier2.go's
+// ReusableBarrier, which tracked generations with a plain int32 counter,
+// this swaps out the release channel itself for each generation: the
+// channel reference *is* the generation token, so there is no counter to
+// wrap around no matter how many trips the barrier makes.
+type Reusable struct {
+	n       int32
+	count   int32
+	release atomic.Pointer[chan struct{}]
+}
+
+// NewReusable returns a Reusable barrier for n participants.
+func NewReusable(n int) *Reusable {
+	b := &Reusable{n: int32(n)}
+	ch := make(chan struct{})
+	b.release.Store(&ch)
+	return b
+}
+
+func (b *Reusable) Wait() {
+	ch := b.release.Load()
+
+	if atomic.AddInt32(&b.count, 1) == b.n {
+		// Last arrival for this generation: reset the counter and swap
+		// in a fresh channel before releasing the current waiters, so
+		// that any participant starting its next round immediately
+		// already sees the new generation.
+		atomic.StoreInt32(&b.count, 0)
+		next := make(chan struct{})
+		b.release.Store(&next)
+		close(*ch)
+		return
+	}
+
+	<-*ch
+}
+
+func (b *Reusable) WaitCtx(ctx context.Context) error {
+	return waitCtx(ctx, b.Wait)
+}
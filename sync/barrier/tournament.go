@@ -0,0 +1,99 @@
+package barrier
+
+import (
+	"context"
+	"math/bits"
+)
+
+// Tournament is a barrier that synchronizes its participants through
+// log2(N) pairwise rounds over a statically precomputed binary tree,
+// instead of funnelling every arrival through one shared mutex the way
+// Central does. Each participant is registered with a stable id
+// (0..n-1) at construction time so the pairing topology for every round
+// can be precomputed once, up front, rather than recomputed per call.
+//
+// At round r, a participant whose id has bit r clear plays the winner
+// role: it waits for the participant id+2^r (its round-r loser) to
+// arrive, if that id exists. A participant whose id has bit r set plays
+// the loser role: it signals its round-r winner (id-2^r) and then blocks
+// waiting to be woken, taking no further part in later rounds. The
+// single overall winner (id 0) wakes the chain back down: each
+// participant it (transitively) beat wakes, in turn, every participant
+// it itself beat, until everyone is released.
+type Tournament struct {
+	n      int
+	rounds int
+	arrive []map[int]chan struct{} // arrive[round][loserID]: loser -> winner
+	wake   []map[int]chan struct{} // wake[round][loserID]: winner -> loser
+}
+
+// NewTournament builds the round topology for n participants. Use
+// Participant to obtain the Barrier handle for a given id.
+func NewTournament(n int) *Tournament {
+	rounds := 0
+	if n > 1 {
+		rounds = bits.Len(uint(n - 1))
+	}
+
+	t := &Tournament{n: n, rounds: rounds}
+	t.arrive = make([]map[int]chan struct{}, rounds)
+	t.wake = make([]map[int]chan struct{}, rounds)
+	for r := 0; r < rounds; r++ {
+		t.arrive[r] = make(map[int]chan struct{})
+		t.wake[r] = make(map[int]chan struct{})
+		mask := 1 << r
+		for id := 0; id < n; id++ {
+			if id&mask == 0 {
+				continue // id is a winner candidate this round, not a loser
+			}
+			t.arrive[r][id] = make(chan struct{})
+			t.wake[r][id] = make(chan struct{})
+		}
+	}
+	return t
+}
+
+// Participant returns the Barrier handle for the participant registered
+// with the given stable id (0..n-1).
+func (t *Tournament) Participant(id int) Barrier {
+	return &tournamentParticipant{t: t, id: id}
+}
+
+type tournamentParticipant struct {
+	t  *Tournament
+	id int
+}
+
+func (p *tournamentParticipant) Wait() {
+	t, id := p.t, p.id
+	var won []int // rounds in which id played winner, in order
+
+	for r := 0; r < t.rounds; r++ {
+		mask := 1 << r
+		if id&mask != 0 {
+			t.arrive[r][id] <- struct{}{}
+			<-t.wake[r][id]
+			p.wake(won)
+			return
+		}
+		if partner := id + mask; partner < t.n {
+			<-t.arrive[r][partner]
+			won = append(won, r)
+		}
+		// else: bye, no partner exists this round; carry on as a winner.
+	}
+	// Every round was either won or a bye: id is the overall champion.
+	p.wake(won)
+}
+
+// wake releases the partners id directly beat, one per round it won.
+// Each of them recurses on its own subtree when it wakes.
+func (p *tournamentParticipant) wake(won []int) {
+	for _, r := range won {
+		p.t.wake[r][p.id+(1<<r)] <- struct{}{}
+	}
+}
+
+func (p *tournamentParticipant) WaitCtx(ctx context.Context) error {
+	return waitCtx(ctx, p.Wait)
+}